@@ -0,0 +1,73 @@
+package v1
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// CronJobTemplate exposes the subset of CronJob/Job tuning knobs an
+// administrator may want to override per index-management policy, rather
+// than being stuck with the operator's hard-coded defaults.
+type CronJobTemplate struct {
+	// Suspend tells the CronJob controller to suspend subsequent executions.
+	// It does not apply to already started executions. Defaults to false.
+	//
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// BackoffLimit is the number of retries before a Job is marked failed.
+	// Defaults to 0.
+	//
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// SuccessfulJobsHistoryLimit is the number of successful finished jobs to
+	// retain. Defaults to 1.
+	//
+	// +optional
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+
+	// FailedJobsHistoryLimit is the number of failed finished jobs to retain.
+	// Defaults to 1.
+	//
+	// +optional
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+
+	// ConcurrencyPolicy dictates how concurrent executions of the same
+	// CronJob are treated. Defaults to Forbid.
+	//
+	// +optional
+	ConcurrencyPolicy batchv1.ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// StartingDeadlineSeconds is the deadline, in seconds, for starting a job
+	// if it misses its scheduled time for any reason.
+	//
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// ActiveDeadlineSeconds is the duration, in seconds, a running job may be
+	// active before the system tries to terminate it.
+	//
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Resources overrides the resource requirements of the index management
+	// container. Defaults to 100m CPU / 32Mi memory requests.
+	//
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Env is a list of additional environment variables to set on the index
+	// management container.
+	//
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// TimeZone is the IANA time zone name the schedule is interpreted in
+	// (e.g. "America/New_York"). Defaults to UTC. Only takes effect against
+	// batch/v1 CronJob; it has no batch/v1beta1 equivalent and is dropped
+	// when the operator falls back to that API.
+	//
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
@@ -0,0 +1,72 @@
+package v1
+
+// PolicyMap indexes IndexManagementPolicySpec by name, as referenced by
+// IndexManagementPolicyMappingSpec.PolicyRef.
+type PolicyMap map[string]IndexManagementPolicySpec
+
+// IndexManagementPolicyMappingSpec binds an index alias/pattern to the
+// index-management policy that governs it, and names the CronJobs generated
+// for that binding.
+type IndexManagementPolicyMappingSpec struct {
+	// Name identifies this mapping and is used, together with the cluster
+	// name, to name the generated CronJobs.
+	Name string `json:"name"`
+
+	// PolicyRef is the name of the IndexManagementPolicySpec to apply.
+	PolicyRef string `json:"policyRef"`
+}
+
+// IndexManagementPolicySpec defines the rollover, warm, and delete behavior
+// applied to indices matching a policy mapping.
+type IndexManagementPolicySpec struct {
+	// PollInterval is how often the index management CronJobs evaluate their
+	// phase conditions (e.g. "15m").
+	PollInterval string `json:"pollInterval,omitempty"`
+
+	// Phases enumerates the lifecycle phases this policy defines.
+	Phases IndexManagementPolicyPhasesSpec `json:"phases"`
+
+	// JobTemplate customizes the CronJobs generated for this policy, rather
+	// than leaving every mapping stuck with the operator's hard-coded
+	// defaults.
+	//
+	// +optional
+	JobTemplate *CronJobTemplate `json:"jobTemplate,omitempty"`
+}
+
+// IndexManagementPolicyPhasesSpec enumerates the lifecycle phases an index
+// can move through under index management.
+type IndexManagementPolicyPhasesSpec struct {
+	// Hot configures rollover of the write index once it meets the
+	// configured conditions.
+	//
+	// +optional
+	Hot *IndexManagementHotPhaseSpec `json:"hot,omitempty"`
+
+	// Warm configures force-merge, read-only, and shrink actions applied to
+	// an index once it ages out of the hot phase.
+	//
+	// +optional
+	Warm *IndexManagementWarmPhaseSpec `json:"warm,omitempty"`
+
+	// Delete configures removal of indices once they reach the configured
+	// age.
+	//
+	// +optional
+	Delete *IndexManagementDeletePhaseSpec `json:"delete,omitempty"`
+}
+
+// IndexManagementHotPhaseSpec configures when the write index for a mapping
+// is rolled over to a new index.
+type IndexManagementHotPhaseSpec struct {
+	// MaxAge is the maximum age an index may reach before it is rolled over.
+	//
+	// +optional
+	MaxAge string `json:"maxAge,omitempty"`
+}
+
+// IndexManagementDeletePhaseSpec configures when an index is deleted.
+type IndexManagementDeletePhaseSpec struct {
+	// MinAge is the minimum age an index must reach before it is deleted.
+	MinAge string `json:"minAge,omitempty"`
+}
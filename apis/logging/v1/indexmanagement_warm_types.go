@@ -0,0 +1,42 @@
+package v1
+
+// IndexManagementWarmPhaseSpec configures the warm phase of an index
+// lifecycle: once an index is at least MinAge old, it is force-merged down
+// to ForceMergeSegments segments, optionally marked read-only, and
+// optionally shrunk to reduce its primary shard count. Moving an index
+// through the warm phase meaningfully reduces steady-state heap and disk
+// usage for long-retention indices.
+type IndexManagementWarmPhaseSpec struct {
+	// MinAge is the minimum age an index must reach, measured from its
+	// creation date, before the warm phase actions are applied to it.
+	MinAge string `json:"minAge,omitempty"`
+
+	// ForceMergeSegments is the max_num_segments passed to the index's
+	// _forcemerge. A value of 1 yields the smallest possible on-disk size
+	// at the cost of a one-time, potentially expensive merge.
+	//
+	// +optional
+	ForceMergeSegments int32 `json:"forceMergeSegments,omitempty"`
+
+	// ReadOnly sets index.blocks.write=true on the index once it reaches
+	// MinAge, preventing further writes.
+	//
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// Shrink, when set, shrinks the index according to ShrinkSpec once it
+	// reaches MinAge.
+	//
+	// +optional
+	Shrink *ShrinkSpec `json:"shrink,omitempty"`
+}
+
+// ShrinkSpec configures the target shard count for the warm-phase shrink
+// action.
+type ShrinkSpec struct {
+	// Shards is the number of primary shards the index is shrunk to.
+	// Defaults to 1.
+	//
+	// +optional
+	Shards int32 `json:"shards,omitempty"`
+}
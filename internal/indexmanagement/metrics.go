@@ -0,0 +1,32 @@
+package indexmanagement
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// reconcileErrorsTotal counts failed CronJob create/update attempts, keyed by
+// the kind of cronjob (rollover, warm, delete) so operator-level reconcile
+// errors are visible alongside the workload-level failures already surfaced
+// by kube_job_failed.
+var reconcileErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "elasticsearch_indexmanagement_reconcile_errors_total",
+	Help: "Number of index management CronJob reconcile (create/update) failures, by cronjob kind.",
+}, []string{"kind"})
+
+func init() {
+	metrics.Registry.MustRegister(reconcileErrorsTotal)
+}
+
+// cronJobKind returns the index-management cronjob kind (rollover, warm,
+// delete) embedded in a CronJob name of the form "<cluster>-<kind>-<mapping>".
+func cronJobKind(name string) string {
+	for _, kind := range []string{"rollover", "warm", "delete"} {
+		if strings.Contains(name, "-"+kind+"-") {
+			return kind
+		}
+	}
+	return "unknown"
+}
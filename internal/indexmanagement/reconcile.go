@@ -5,15 +5,17 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"os"
 	"reflect"
 	"strconv"
 
 	"github.com/ViaQ/logerr/kverrors"
-	batchv1 "k8s.io/api/batch/v1"
-	batch "k8s.io/api/batch/v1beta1"
+	batch "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/retry"
@@ -26,12 +28,16 @@ import (
 	"github.com/openshift/elasticsearch-operator/internal/constants"
 	"github.com/openshift/elasticsearch-operator/internal/types/k8s"
 	"github.com/openshift/elasticsearch-operator/internal/utils"
-	"github.com/openshift/elasticsearch-operator/internal/utils/comparators"
 )
 
 const (
 	indexManagementConfigmap = "indexmanagement-scripts"
 	defaultShardSize         = int32(40)
+
+	// lastAppliedConfigAnnotation stores the JSON-encoded CronJobSpec the
+	// operator last applied, so reconcileCronJob can detect drift in a
+	// single comparison instead of enumerating every field it knows about.
+	lastAppliedConfigAnnotation = "logging.openshift.io/last-applied-configuration"
 )
 
 var (
@@ -54,8 +60,38 @@ var (
 		"component":     "indexManagement",
 		"logging-infra": "indexManagement",
 	}
+
+	// defaultSuspendAll is the cluster-wide override for CronJobTemplate.Suspend.
+	// When set, it takes precedence over every policy's own JobTemplate.Suspend
+	// so an administrator can pause all index-management jobs for maintenance
+	// with a single knob, rather than editing every policy. See SetDefaultSuspendAll.
+	defaultSuspendAll *bool
 )
 
+// SetDefaultSuspendAll configures the cluster-wide suspend override applied to
+// every index-management CronJob, regardless of what an individual policy's
+// JobTemplate requests. Intended to be called once, from operator config.
+func SetDefaultSuspendAll(suspend *bool) {
+	defaultSuspendAll = suspend
+}
+
+// suspendAllEnvVar is the operator config knob an administrator sets to pause
+// every index-management CronJob, read once at startup via init below.
+const suspendAllEnvVar = "INDEX_MANAGEMENT_SUSPEND_ALL"
+
+func init() {
+	value, ok := os.LookupEnv(suspendAllEnvVar)
+	if !ok {
+		return
+	}
+	suspend, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Error(err, "ignoring invalid value for env var", "env", suspendAllEnvVar, "value", value)
+		return
+	}
+	SetDefaultSuspendAll(&suspend)
+}
+
 type rolloverConditions struct {
 	MaxAge  string `json:"max_age,omitempty"`
 	MaxDocs int32  `json:"max_docs,omitempty"`
@@ -69,39 +105,60 @@ func RemoveCronJobsForMappings(apiclient client.Client, cluster *apis.Elasticsea
 		if policy.Phases.Hot != nil {
 			expected.Insert(fmt.Sprintf("%s-rollover-%s", cluster.Name, mapping.Name))
 		}
+		if policy.Phases.Warm != nil {
+			expected.Insert(fmt.Sprintf("%s-warm-%s", cluster.Name, mapping.Name))
+		}
 		if policy.Phases.Delete != nil {
 			expected.Insert(fmt.Sprintf("%s-delete-%s", cluster.Name, mapping.Name))
 		}
 	}
 
-	cronList := &batch.CronJobList{}
 	listOpts := []client.ListOption{
 		client.InNamespace(cluster.Namespace),
 		client.MatchingLabels(imLabels),
 	}
-	if err := apiclient.List(context.TODO(), cronList, listOpts...); err != nil {
-		return kverrors.Wrap(err, "failed to list cron jobs",
-			"namespace", cluster.Namespace,
-			"labels", imLabels,
-		)
-	}
 	existing := sets.NewString()
-	for _, cron := range cronList.Items {
-		existing.Insert(cron.Name)
+	if usesV1beta1CronJob(apiclient) {
+		cronList := &batchv1beta1.CronJobList{}
+		if err := apiclient.List(context.TODO(), cronList, listOpts...); err != nil {
+			return kverrors.Wrap(err, "failed to list cron jobs",
+				"namespace", cluster.Namespace,
+				"labels", imLabels,
+			)
+		}
+		for _, cron := range cronList.Items {
+			existing.Insert(cron.Name)
+		}
+	} else {
+		cronList := &batch.CronJobList{}
+		if err := apiclient.List(context.TODO(), cronList, listOpts...); err != nil {
+			return kverrors.Wrap(err, "failed to list cron jobs",
+				"namespace", cluster.Namespace,
+				"labels", imLabels,
+			)
+		}
+		for _, cron := range cronList.Items {
+			existing.Insert(cron.Name)
+		}
 	}
 	difference := existing.Difference(expected)
 	for _, name := range difference.List() {
-		cronjob := &batch.CronJob{
-			TypeMeta: metav1.TypeMeta{
-				Kind:       "CronJob",
-				APIVersion: batch.SchemeGroupVersion.String(),
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      name,
-				Namespace: cluster.Namespace,
-			},
+		objMeta := metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.Namespace,
+		}
+		var err error
+		if usesV1beta1CronJob(apiclient) {
+			err = apiclient.Delete(context.TODO(), &batchv1beta1.CronJob{
+				TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: batchv1beta1.SchemeGroupVersion.String()},
+				ObjectMeta: objMeta,
+			})
+		} else {
+			err = apiclient.Delete(context.TODO(), &batch.CronJob{
+				TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: batch.SchemeGroupVersion.String()},
+				ObjectMeta: objMeta,
+			})
 		}
-		err := apiclient.Delete(context.TODO(), cronjob)
 		if err != nil && !apierrors.IsNotFound(err) {
 			log.Error(err, "failed to remove cronjob", "namespace", cluster.Namespace, "name", name)
 		}
@@ -167,10 +224,55 @@ func ReconcileRolloverCronjob(apiclient client.Client, cluster *apis.Elasticsear
 			"/tmp/scripts/rollover",
 		}
 	}
-	desired := newCronJob(cluster.Name, constants.PackagedElasticsearchImage(), cluster.Namespace, name, schedule, cluster.Spec.Spec.NodeSelector, cluster.Spec.Spec.Tolerations, envvars, fnContainerHandler)
+	desired := newCronJob(cluster.Name, constants.PackagedElasticsearchImage(), cluster.Namespace, name, schedule, mapping.Name, cluster.Spec.Spec, envvars, policy.JobTemplate, fnContainerHandler)
+
+	cluster.AddOwnerRefTo(desired)
+	return reconcileCronJob(apiclient, cluster, desired)
+}
+
+func ReconcileWarmCronjob(apiclient client.Client, cluster *apis.Elasticsearch, policy apis.IndexManagementPolicySpec, mapping apis.IndexManagementPolicyMappingSpec, primaryShards int32) error {
+	if policy.Phases.Warm == nil {
+		log.Info("Skipping warm cronjob for policymapping; warm phase not defined", "policymapping", mapping.Name)
+		return nil
+	}
+	schedule, err := crontabScheduleFor(policy.PollInterval)
+	if err != nil {
+		return kverrors.Wrap(err, "failed to reconcile warm cronjob", "policymapping", mapping.Name)
+	}
+	minAgeMillis, err := calculateMillisForTimeUnit(policy.Phases.Warm.MinAge)
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-warm-%s", cluster.Name, mapping.Name)
+	forceMergeSegments := policy.Phases.Warm.ForceMergeSegments
+	if forceMergeSegments == 0 {
+		forceMergeSegments = 1
+	}
+	shrinkShards := int32(0)
+	if policy.Phases.Warm.Shrink != nil {
+		shrinkShards = policy.Phases.Warm.Shrink.Shards
+		if shrinkShards == 0 {
+			shrinkShards = 1
+		}
+	}
+	envvars := []corev1.EnvVar{
+		{Name: "POLICY_MAPPING", Value: mapping.Name},
+		{Name: "MIN_AGE", Value: strconv.FormatUint(minAgeMillis, 10)},
+		{Name: "FORCE_MERGE_SEGMENTS", Value: strconv.FormatInt(int64(forceMergeSegments), 10)},
+		{Name: "READ_ONLY", Value: strconv.FormatBool(policy.Phases.Warm.ReadOnly)},
+		{Name: "SHRINK_SHARDS", Value: strconv.FormatInt(int64(shrinkShards), 10)},
+	}
+	fnContainerHandler := func(container *corev1.Container) {
+		container.Command = []string{"bash"}
+		container.Args = []string{
+			"-c",
+			"/tmp/scripts/warm",
+		}
+	}
+	desired := newCronJob(cluster.Name, constants.PackagedElasticsearchImage(), cluster.Namespace, name, schedule, mapping.Name, cluster.Spec.Spec, envvars, policy.JobTemplate, fnContainerHandler)
 
 	cluster.AddOwnerRefTo(desired)
-	return reconcileCronJob(apiclient, cluster, desired, areCronJobsSame)
+	return reconcileCronJob(apiclient, cluster, desired)
 }
 
 func ReconcileCurationCronjob(apiclient client.Client, cluster *apis.Elasticsearch, policy apis.IndexManagementPolicySpec, mapping apis.IndexManagementPolicyMappingSpec, primaryShards int32) error {
@@ -198,18 +300,83 @@ func ReconcileCurationCronjob(apiclient client.Client, cluster *apis.Elasticsear
 			"/tmp/scripts/delete",
 		}
 	}
-	desired := newCronJob(cluster.Name, constants.PackagedElasticsearchImage(), cluster.Namespace, name, schedule, cluster.Spec.Spec.NodeSelector, cluster.Spec.Spec.Tolerations, envvars, fnContainerHandler)
+	desired := newCronJob(cluster.Name, constants.PackagedElasticsearchImage(), cluster.Namespace, name, schedule, mapping.Name, cluster.Spec.Spec, envvars, policy.JobTemplate, fnContainerHandler)
 
 	cluster.AddOwnerRefTo(desired)
-	return reconcileCronJob(apiclient, cluster, desired, areCronJobsSame)
+	return reconcileCronJob(apiclient, cluster, desired)
+}
+
+// usesV1beta1CronJob reports whether the target cluster only serves the
+// deprecated batch/v1beta1 CronJob API. Kubernetes stopped serving it in
+// 1.25, but clusters between 1.21 and 1.24 may still have it enabled while
+// batch/v1 is also available, so batch/v1 is always preferred when present.
+func usesV1beta1CronJob(apiclient client.Client) bool {
+	mapper := apiclient.RESTMapper()
+	if mapper == nil {
+		return false
+	}
+	if _, err := mapper.RESTMapping(schema.GroupKind{Group: "batch", Kind: "CronJob"}, "v1"); err == nil {
+		return false
+	}
+	if _, err := mapper.RESTMapping(schema.GroupKind{Group: "batch", Kind: "CronJob"}, "v1beta1"); err == nil {
+		return true
+	}
+	return false
 }
 
-func reconcileCronJob(apiclient client.Client, cluster *apis.Elasticsearch, desired *batch.CronJob, fnAreCronJobsSame func(lhs, rhs *batch.CronJob) bool) error {
+// toV1beta1CronJob downconverts a batch/v1 CronJob to batch/v1beta1 for
+// clusters that do not serve batch/v1. TimeZone has no v1beta1 equivalent
+// and is dropped.
+func toV1beta1CronJob(cj *batch.CronJob) *batchv1beta1.CronJob {
+	return &batchv1beta1.CronJob{
+		TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: batchv1beta1.SchemeGroupVersion.String()},
+		ObjectMeta: cj.ObjectMeta,
+		Spec: batchv1beta1.CronJobSpec{
+			Schedule:                   cj.Spec.Schedule,
+			StartingDeadlineSeconds:    cj.Spec.StartingDeadlineSeconds,
+			ConcurrencyPolicy:          batchv1beta1.ConcurrencyPolicy(cj.Spec.ConcurrencyPolicy),
+			Suspend:                    cj.Spec.Suspend,
+			SuccessfulJobsHistoryLimit: cj.Spec.SuccessfulJobsHistoryLimit,
+			FailedJobsHistoryLimit:     cj.Spec.FailedJobsHistoryLimit,
+			JobTemplate: batchv1beta1.JobTemplateSpec{
+				ObjectMeta: cj.Spec.JobTemplate.ObjectMeta,
+				Spec:       cj.Spec.JobTemplate.Spec,
+			},
+		},
+	}
+}
+
+// stampLastAppliedConfig marshals spec and stores it on the CronJob under
+// lastAppliedConfigAnnotation, so a later reconcile can tell whether the
+// object still matches what the operator last applied without having to
+// enumerate every field it knows about.
+func stampLastAppliedConfig(obj *batch.CronJob, spec batch.CronJobSpec) error {
+	encoded, err := json.Marshal(spec)
+	if err != nil {
+		return kverrors.Wrap(err, "failed to serialize cronjob spec for last-applied-configuration annotation")
+	}
+	if obj.Annotations == nil {
+		obj.Annotations = map[string]string{}
+	}
+	obj.Annotations[lastAppliedConfigAnnotation] = string(encoded)
+	return nil
+}
+
+func reconcileCronJob(apiclient client.Client, cluster *apis.Elasticsearch, desired *batch.CronJob) error {
+	if err := stampLastAppliedConfig(desired, desired.Spec); err != nil {
+		return err
+	}
+
+	if usesV1beta1CronJob(apiclient) {
+		return reconcileV1beta1CronJob(apiclient, cluster, desired)
+	}
+
 	err := apiclient.Create(context.TODO(), desired)
 	if err == nil {
 		return nil
 	}
 	if !apierrors.IsAlreadyExists(err) {
+		reconcileErrorsTotal.WithLabelValues(cronJobKind(desired.Name)).Inc()
 		return kverrors.Wrap(err, "failed to create cronjob for cluster",
 			"namespace", cluster.Namespace,
 			"cluster", cluster.Name)
@@ -220,80 +387,113 @@ func reconcileCronJob(apiclient client.Client, cluster *apis.Elasticsearch, desi
 		if retryError != nil {
 			return retryError
 		}
-		if !fnAreCronJobsSame(current, desired) {
+		if current.Annotations[lastAppliedConfigAnnotation] != desired.Annotations[lastAppliedConfigAnnotation] {
 			current.Spec = desired.Spec
+			current.Labels = desired.Labels
+			if current.Annotations == nil {
+				current.Annotations = map[string]string{}
+			}
+			current.Annotations[lastAppliedConfigAnnotation] = desired.Annotations[lastAppliedConfigAnnotation]
 			return apiclient.Update(context.TODO(), current)
 		}
 		return nil
 	})
+	if err != nil {
+		reconcileErrorsTotal.WithLabelValues(cronJobKind(desired.Name)).Inc()
+	}
 	return kverrors.Wrap(err, "failed to update cronjob for cluster",
 		"namespace", desired.Namespace,
 		"cluster", desired.Name)
 }
 
-func areCronJobsSame(lhs, rhs *batch.CronJob) bool {
-	if len(lhs.Spec.JobTemplate.Spec.Template.Spec.Containers) != len(lhs.Spec.JobTemplate.Spec.Template.Spec.Containers) {
-		return false
-	}
-	if !comparators.AreStringMapsSame(lhs.Spec.JobTemplate.Spec.Template.Spec.NodeSelector, rhs.Spec.JobTemplate.Spec.Template.Spec.NodeSelector) {
-		return false
-	}
-
-	if !comparators.AreTolerationsSame(lhs.Spec.JobTemplate.Spec.Template.Spec.Tolerations, rhs.Spec.JobTemplate.Spec.Template.Spec.Tolerations) {
-		return false
-	}
-	if lhs.Spec.Schedule != rhs.Spec.Schedule {
-		lhs.Spec.Schedule = rhs.Spec.Schedule
-		return false
+// reconcileV1beta1CronJob is the fallback path for clusters that do not yet
+// serve batch/v1 CronJob. The desired spec is always built against batch/v1
+// and converted down at the API boundary so callers only ever deal with
+// one CronJob type; the last-applied-configuration annotation always stores
+// the batch/v1 spec so drift detection is identical on both paths.
+func reconcileV1beta1CronJob(apiclient client.Client, cluster *apis.Elasticsearch, desired *batch.CronJob) error {
+	desiredV1beta1 := toV1beta1CronJob(desired)
+	err := apiclient.Create(context.TODO(), desiredV1beta1)
+	if err == nil {
+		return nil
 	}
-	if lhs.Spec.Suspend != nil && rhs.Spec.Suspend != nil && *lhs.Spec.Suspend != *rhs.Spec.Suspend {
-		return false
+	if !apierrors.IsAlreadyExists(err) {
+		reconcileErrorsTotal.WithLabelValues(cronJobKind(desired.Name)).Inc()
+		return kverrors.Wrap(err, "failed to create cronjob for cluster",
+			"namespace", cluster.Namespace,
+			"cluster", cluster.Name)
 	}
-
-	for i, container := range lhs.Spec.JobTemplate.Spec.Template.Spec.Containers {
-		other := rhs.Spec.JobTemplate.Spec.Template.Spec.Containers[i]
-		if container.Name != other.Name {
-			return false
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &batchv1beta1.CronJob{}
+		retryError := apiclient.Get(context.TODO(), types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, current)
+		if retryError != nil {
+			return retryError
 		}
-		if container.Image != other.Image {
-			return false
+		if current.Annotations[lastAppliedConfigAnnotation] != desired.Annotations[lastAppliedConfigAnnotation] {
+			current.Spec = desiredV1beta1.Spec
+			current.Labels = desiredV1beta1.Labels
+			if current.Annotations == nil {
+				current.Annotations = map[string]string{}
+			}
+			current.Annotations[lastAppliedConfigAnnotation] = desired.Annotations[lastAppliedConfigAnnotation]
+			return apiclient.Update(context.TODO(), current)
 		}
+		return nil
+	})
+	if err != nil {
+		reconcileErrorsTotal.WithLabelValues(cronJobKind(desired.Name)).Inc()
+	}
+	return kverrors.Wrap(err, "failed to update cronjob for cluster",
+		"namespace", desired.Namespace,
+		"cluster", desired.Name)
+}
 
-		if !reflect.DeepEqual(container.Command, other.Command) {
-			return false
-		}
-		if !reflect.DeepEqual(container.Args, other.Args) {
-			return false
-		}
+// effectiveJobTemplate applies the cluster-wide defaultSuspendAll override on
+// top of a policy's own JobTemplate, without mutating the policy's spec.
+func effectiveJobTemplate(jobTemplate *apis.CronJobTemplate) apis.CronJobTemplate {
+	var effective apis.CronJobTemplate
+	if jobTemplate != nil {
+		effective = *jobTemplate
+	}
+	if defaultSuspendAll != nil {
+		effective.Suspend = defaultSuspendAll
+	}
+	return effective
+}
 
-		if !comparators.AreResourceRequementsSame(container.Resources, other.Resources) {
-			return false
-		}
+func newCronJob(clusterName, image, namespace, name, schedule, policyMapping string, nodePlacement apis.ElasticsearchNodeSpec, envvars []corev1.EnvVar, jobTemplate *apis.CronJobTemplate, fnContainerHander func(*corev1.Container)) *batch.CronJob {
+	jt := effectiveJobTemplate(jobTemplate)
 
-		if !comparators.EnvValueEqual(container.Env, other.Env) {
-			return false
-		}
+	// Copy imLabels rather than reusing it directly: it is a shared package
+	// var also used for listing/deleting cronjobs, so mutating it here would
+	// corrupt every other CronJob built from it.
+	labels := make(map[string]string, len(imLabels)+1)
+	for k, v := range imLabels {
+		labels[k] = v
+	}
+	labels["policymapping"] = policyMapping
 
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: defaultMemoryRequest,
+			corev1.ResourceCPU:    defaultCPURequest,
+		},
+	}
+	if !reflect.DeepEqual(jt.Resources, corev1.ResourceRequirements{}) {
+		resources = jt.Resources
 	}
-	return true
-}
 
-func newCronJob(clusterName, image, namespace, name, schedule string, nodeSelector map[string]string, tolerations []corev1.Toleration, envvars []corev1.EnvVar, fnContainerHander func(*corev1.Container)) *batch.CronJob {
 	container := corev1.Container{
 		Name:            "indexmanagement",
 		Image:           image,
 		ImagePullPolicy: corev1.PullIfNotPresent,
-		Resources: corev1.ResourceRequirements{
-			Requests: corev1.ResourceList{
-				corev1.ResourceMemory: defaultMemoryRequest,
-				corev1.ResourceCPU:    defaultCPURequest,
-			},
-		},
+		Resources:       resources,
 		Env: []corev1.EnvVar{
 			{Name: "ES_SERVICE", Value: fmt.Sprintf("https://%s:9200", clusterName)},
 		},
 	}
 	container.Env = append(container.Env, envvars...)
+	container.Env = append(container.Env, jt.Env...)
 	fnContainerHander(&container)
 
 	container.VolumeMounts = []corev1.VolumeMount{
@@ -307,12 +507,33 @@ func newCronJob(clusterName, image, namespace, name, schedule string, nodeSelect
 			{Name: "certs", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: clusterName}}},
 			{Name: "scripts", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: indexManagementConfigmap}, DefaultMode: &fullExecMode}}},
 		},
-		NodeSelector:                  utils.EnsureLinuxNodeSelector(nodeSelector),
-		Tolerations:                   tolerations,
+		NodeSelector:                  utils.EnsureLinuxNodeSelector(nodePlacement.NodeSelector),
+		Tolerations:                   nodePlacement.Tolerations,
+		Affinity:                      nodePlacement.Affinity,
+		PriorityClassName:             nodePlacement.PriorityClassName,
+		RuntimeClassName:              nodePlacement.RuntimeClassName,
+		TopologySpreadConstraints:     nodePlacement.TopologySpreadConstraints,
 		RestartPolicy:                 corev1.RestartPolicyNever,
 		TerminationGracePeriodSeconds: utils.GetInt64(300),
 	}
 
+	concurrencyPolicy := batch.ForbidConcurrent
+	if jt.ConcurrencyPolicy != "" {
+		concurrencyPolicy = jt.ConcurrencyPolicy
+	}
+	successHistoryLimit := jobHistoryLimitSuccess
+	if jt.SuccessfulJobsHistoryLimit != nil {
+		successHistoryLimit = jt.SuccessfulJobsHistoryLimit
+	}
+	failedHistoryLimit := jobHistoryLimitFailed
+	if jt.FailedJobsHistoryLimit != nil {
+		failedHistoryLimit = jt.FailedJobsHistoryLimit
+	}
+	backoffLimit := utils.GetInt32(0)
+	if jt.BackoffLimit != nil {
+		backoffLimit = jt.BackoffLimit
+	}
+
 	cronJob := &batch.CronJob{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "CronJob",
@@ -321,22 +542,29 @@ func newCronJob(clusterName, image, namespace, name, schedule string, nodeSelect
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
-			Labels:    imLabels,
+			Labels:    labels,
 		},
 		Spec: batch.CronJobSpec{
-			ConcurrencyPolicy:          batch.ForbidConcurrent,
-			SuccessfulJobsHistoryLimit: jobHistoryLimitSuccess,
-			FailedJobsHistoryLimit:     jobHistoryLimitFailed,
+			ConcurrencyPolicy:          concurrencyPolicy,
+			SuccessfulJobsHistoryLimit: successHistoryLimit,
+			FailedJobsHistoryLimit:     failedHistoryLimit,
 			Schedule:                   schedule,
+			TimeZone:                   jt.TimeZone,
+			Suspend:                    jt.Suspend,
+			StartingDeadlineSeconds:    jt.StartingDeadlineSeconds,
 			JobTemplate: batch.JobTemplateSpec{
-				Spec: batchv1.JobSpec{
-					BackoffLimit: utils.GetInt32(0),
-					Parallelism:  utils.GetInt32(1),
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: batch.JobSpec{
+					BackoffLimit:          backoffLimit,
+					Parallelism:           utils.GetInt32(1),
+					ActiveDeadlineSeconds: jt.ActiveDeadlineSeconds,
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Name:      name,
 							Namespace: namespace,
-							Labels:    imLabels,
+							Labels:    labels,
 						},
 						Spec: podSpec,
 					},
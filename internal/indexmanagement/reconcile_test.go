@@ -0,0 +1,148 @@
+package indexmanagement
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	batch "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apis "github.com/openshift/elasticsearch-operator/apis/logging/v1"
+)
+
+// restMapperClient wraps the fake client so tests can simulate clusters that
+// only serve one of batch/v1 or batch/v1beta1 CronJob.
+type restMapperClient struct {
+	client.Client
+	mapper meta.RESTMapper
+}
+
+func (c *restMapperClient) RESTMapper() meta.RESTMapper {
+	return c.mapper
+}
+
+func newCronJobMapper(groupVersion schema.GroupVersion) meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{groupVersion})
+	mapper.Add(groupVersion.WithKind("CronJob"), meta.RESTScopeNamespace)
+	return mapper
+}
+
+var _ = Describe("reconcileCronJob", func() {
+	defer GinkgoRecover()
+
+	var (
+		cluster = &apis.Elasticsearch{
+			ObjectMeta: metav1.ObjectMeta{Name: "aCluster", Namespace: "aNamespace"},
+		}
+		desired = &batch.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "aCluster-rollover-app", Namespace: "aNamespace"},
+			Spec:       batch.CronJobSpec{Schedule: "*/5 * * * *"},
+		}
+		nsName = types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}
+	)
+
+	Context("when the cluster only serves batch/v1beta1", func() {
+		It("should fall back to creating a v1beta1 CronJob", func() {
+			apiclient := &restMapperClient{
+				Client: fake.NewClientBuilder().Build(),
+				mapper: newCronJobMapper(batchv1beta1.SchemeGroupVersion),
+			}
+			Expect(usesV1beta1CronJob(apiclient)).To(BeTrue())
+			Expect(reconcileCronJob(apiclient, cluster, desired.DeepCopy())).To(Succeed())
+
+			current := &batchv1beta1.CronJob{}
+			Expect(apiclient.Get(context.TODO(), nsName, current)).To(Succeed())
+			Expect(current.Spec.Schedule).To(Equal(desired.Spec.Schedule))
+		})
+	})
+
+	Context("when the cluster serves batch/v1", func() {
+		It("should create the CronJob directly against batch/v1", func() {
+			apiclient := &restMapperClient{
+				Client: fake.NewClientBuilder().Build(),
+				mapper: newCronJobMapper(batch.SchemeGroupVersion),
+			}
+			Expect(usesV1beta1CronJob(apiclient)).To(BeFalse())
+			Expect(reconcileCronJob(apiclient, cluster, desired.DeepCopy())).To(Succeed())
+
+			current := &batch.CronJob{}
+			Expect(apiclient.Get(context.TODO(), nsName, current)).To(Succeed())
+			Expect(current.Spec.Schedule).To(Equal(desired.Spec.Schedule))
+		})
+
+		It("should apply the desired spec and refresh the last-applied-configuration annotation when it drifts", func() {
+			apiclient := &restMapperClient{
+				Client: fake.NewClientBuilder().Build(),
+				mapper: newCronJobMapper(batch.SchemeGroupVersion),
+			}
+			Expect(reconcileCronJob(apiclient, cluster, desired.DeepCopy())).To(Succeed())
+
+			current := &batch.CronJob{}
+			Expect(apiclient.Get(context.TODO(), nsName, current)).To(Succeed())
+			appliedConfig := current.Annotations[lastAppliedConfigAnnotation]
+			Expect(appliedConfig).NotTo(BeEmpty())
+
+			changed := desired.DeepCopy()
+			changed.Spec.Schedule = "0 0 * * *"
+			Expect(reconcileCronJob(apiclient, cluster, changed)).To(Succeed())
+
+			Expect(apiclient.Get(context.TODO(), nsName, current)).To(Succeed())
+			Expect(current.Spec.Schedule).To(Equal(changed.Spec.Schedule))
+			Expect(current.Annotations[lastAppliedConfigAnnotation]).NotTo(Equal(appliedConfig))
+		})
+
+		It("should not panic when an existing CronJob has a nil Annotations map", func() {
+			apiclient := &restMapperClient{
+				Client: fake.NewClientBuilder().Build(),
+				mapper: newCronJobMapper(batch.SchemeGroupVersion),
+			}
+			preexisting := desired.DeepCopy()
+			preexisting.Annotations = nil
+			Expect(apiclient.Create(context.TODO(), preexisting)).To(Succeed())
+
+			changed := desired.DeepCopy()
+			changed.Spec.Schedule = "0 0 * * *"
+			Expect(reconcileCronJob(apiclient, cluster, changed)).To(Succeed())
+
+			current := &batch.CronJob{}
+			Expect(apiclient.Get(context.TODO(), nsName, current)).To(Succeed())
+			Expect(current.Spec.Schedule).To(Equal(changed.Spec.Schedule))
+			Expect(current.Annotations[lastAppliedConfigAnnotation]).NotTo(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("newCronJob", func() {
+	defer GinkgoRecover()
+
+	It("should propagate NodePlacement onto the generated pod spec", func() {
+		runtimeClassName := "myRuntimeClass"
+		nodePlacement := apis.ElasticsearchNodeSpec{
+			Tolerations:       []corev1.Toleration{{Key: "node-role", Operator: corev1.TolerationOpExists}},
+			Affinity:          &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}},
+			PriorityClassName: "system-cluster-critical",
+			RuntimeClassName:  &runtimeClassName,
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+				{MaxSkew: 1, TopologyKey: "kubernetes.io/hostname"},
+			},
+		}
+
+		cronJob := newCronJob("aCluster", "anImage", "aNamespace", "aCluster-rollover-app", "*/5 * * * *", "app",
+			nodePlacement, nil, nil, func(*corev1.Container) {})
+
+		podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+		Expect(podSpec.Tolerations).To(Equal(nodePlacement.Tolerations))
+		Expect(podSpec.Affinity).To(Equal(nodePlacement.Affinity))
+		Expect(podSpec.PriorityClassName).To(Equal(nodePlacement.PriorityClassName))
+		Expect(podSpec.RuntimeClassName).To(Equal(nodePlacement.RuntimeClassName))
+		Expect(podSpec.TopologySpreadConstraints).To(Equal(nodePlacement.TopologySpreadConstraints))
+	})
+})
@@ -0,0 +1,90 @@
+package indexmanagement
+
+// scriptMap holds the shell scripts mounted into every index management
+// CronJob's pod at /tmp/scripts (see newCronJob's "scripts" volume), keyed
+// by the script name the CronJob's container command execs directly.
+var scriptMap = map[string]string{
+	"rollover": rolloverScript,
+	"warm":     warmScript,
+	"delete":   deleteScript,
+}
+
+const rolloverScript = `#!/bin/bash
+set -euo pipefail
+
+payload=$(echo "${PAYLOAD}" | base64 -d)
+response=$(curl -s -w '\n%{http_code}' --cacert /etc/indexmanagement/keys/admin-ca \
+  --cert /etc/indexmanagement/keys/admin-cert --key /etc/indexmanagement/keys/admin-key \
+  -XPOST "${ES_SERVICE}/${POLICY_MAPPING}-write/_rollover" -H 'Content-Type: application/json' -d "${payload}")
+code=$(echo "${response}" | tail -n1)
+if [ "${code}" -ge 300 ]; then
+  echo "${response}" >&2
+  exit 1
+fi
+`
+
+// warmScript and deleteScript both need to know which of a mapping's
+// rollover-managed indices (named "<mapping>-000001", "<mapping>-000002",
+// ...) is the current write index, so they never act on it, and how old
+// each of the rest is, so they only act on ones that have reached MIN_AGE.
+// indexAgesScript (sourced by both, not a standalone entry in scriptMap)
+// enumerates that via _alias and _cat/indices and emits "<index>
+// <creation_ms>" lines (creation.date in epoch millis) for every index
+// matching "<mapping>-*" except the write index itself.
+const indexAgesScript = `curl_es() {
+  curl -s --cacert /etc/indexmanagement/keys/admin-ca \
+    --cert /etc/indexmanagement/keys/admin-cert --key /etc/indexmanagement/keys/admin-key "$@"
+}
+
+write_index=$(curl_es -f "${ES_SERVICE}/_alias/${POLICY_MAPPING}-write" | jq -r 'keys[0]')
+now_millis=$(date +%s%3N)
+
+curl_es -f "${ES_SERVICE}/_cat/indices/${POLICY_MAPPING}-*?h=index,creation.date&format=json" \
+  | jq -r --arg write_index "${write_index}" \
+      '.[] | select(.index != $write_index) | "\(.index) \(.["creation.date"])"'
+`
+
+const warmScript = `#!/bin/bash
+set -euo pipefail
+
+curl_es() {
+  curl -s -f --cacert /etc/indexmanagement/keys/admin-ca \
+    --cert /etc/indexmanagement/keys/admin-cert --key /etc/indexmanagement/keys/admin-key "$@"
+}
+now_millis=$(date +%s%3N)
+
+while read -r index created; do
+  age=$(( now_millis - created ))
+  if [ "${age}" -lt "${MIN_AGE}" ]; then
+    continue
+  fi
+
+  curl_es -XPOST "${ES_SERVICE}/${index}/_forcemerge?max_num_segments=${FORCE_MERGE_SEGMENTS}"
+
+  if [ "${READ_ONLY}" = "true" ] || [ "${SHRINK_SHARDS}" -gt 0 ]; then
+    curl_es -XPUT "${ES_SERVICE}/${index}/_settings" -H 'Content-Type: application/json' \
+      -d '{"index.blocks.write": true}'
+  fi
+
+  if [ "${SHRINK_SHARDS}" -gt 0 ]; then
+    curl_es -XPOST "${ES_SERVICE}/${index}/_shrink/${index}-shrunk" -H 'Content-Type: application/json' \
+      -d "{\"settings\": {\"index.number_of_shards\": ${SHRINK_SHARDS}}}"
+  fi
+done < <(` + indexAgesScript + `)
+`
+
+const deleteScript = `#!/bin/bash
+set -euo pipefail
+
+now_millis=$(date +%s%3N)
+
+while read -r index created; do
+  age=$(( now_millis - created ))
+  if [ "${age}" -lt "${MIN_AGE}" ]; then
+    continue
+  fi
+  curl -s -f --cacert /etc/indexmanagement/keys/admin-ca \
+    --cert /etc/indexmanagement/keys/admin-cert --key /etc/indexmanagement/keys/admin-key \
+    -XDELETE "${ES_SERVICE}/${index}"
+done < <(` + indexAgesScript + `)
+`